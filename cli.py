@@ -17,6 +17,7 @@ COMMANDS = {
     "db-pull": "Pull production DB into data/backups/ (stops/starts prod)",
     "db-use-prod": "Copy a backup to data/plow.db for local dev",
     "signups": "Export newsletter signups to CSV and HTML",
+    "env": "List settings with defaults and current values",
 }
 
 APP = "where_the_plow.main:app"
@@ -407,6 +408,21 @@ def signups():
     print(f"  HTML: {html_path.relative_to(ROOT)}")
 
 
+def env():
+    from where_the_plow.config import Settings
+
+    # Read straight from the Settings model so this list can't drift from config.py.
+    current = Settings()
+    print("Precedence: environment > .env file > default\n")
+    for name, field in Settings.model_fields.items():
+        value = getattr(current, name)
+        marker = "" if value == field.default else "  (overridden)"
+        print(name.upper())
+        print(f"  {field.description}")
+        print(f"  default: {field.default}")
+        print(f"  current: {value}{marker}")
+
+
 def usage():
     print("Usage: uv run cli.py <command>\n")
     print("Commands:")
@@ -427,6 +443,7 @@ def main():
         "db-pull": db_pull,
         "db-use-prod": db_use_prod,
         "signups": signups,
+        "env": env,
     }
     dispatch[cmd]()
 
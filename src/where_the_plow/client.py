@@ -1,5 +1,9 @@
+import json
 import logging
 from datetime import datetime, timedelta, timezone
+from pathlib import Path
+from urllib.parse import urlparse
+from urllib.request import url2pathname
 
 import httpx
 from pydantic import BaseModel, field_validator
@@ -341,8 +345,26 @@ def parse_hitechmaps_response(
     return vehicles, positions
 
 
+def is_file_url(url: str) -> bool:
+    """True for any file: URL (file:/path, file:///path, file://localhost/path)."""
+    return urlparse(url).scheme == "file"
+
+
+def _file_url_path(url: str) -> Path:
+    """Resolve a file:// URL (optionally file://localhost/...) to a local path."""
+    parsed = urlparse(url)
+    if parsed.netloc not in ("", "localhost"):
+        raise ValueError(f"Unsupported file URL host: {url}")
+    return Path(url2pathname(parsed.path))
+
+
 async def fetch_source(client: httpx.AsyncClient, source) -> dict | list:
     """Fetch data from any source. Returns raw JSON (dict for AVL, list for AATracking)."""
+    if is_file_url(source.api_url):
+        # Offline fixture (dev/test only): the file holds the final payload,
+        # so multi-step sources like geotab skip their signed-URL hop.
+        return json.loads(_file_url_path(source.api_url).read_text())
+
     headers = {}
     params = {}
 
@@ -359,15 +381,15 @@ async def fetch_source(client: httpx.AsyncClient, source) -> dict | list:
 
     if source.parser == "geotab":
         # Two-step fetch: get signed URL, then fetch data from GCS bucket
-        resp = await client.get(source.api_url, timeout=10)
+        resp = await client.get(source.api_url)
         resp.raise_for_status()
         signed_url = resp.json()["url"]
 
-        resp = await client.get(signed_url, timeout=10)
+        resp = await client.get(signed_url)
         resp.raise_for_status()
         return resp.json()
 
-    resp = await client.get(source.api_url, params=params, headers=headers, timeout=10)
+    resp = await client.get(source.api_url, params=params, headers=headers)
     resp.raise_for_status()
     data = resp.json()
 
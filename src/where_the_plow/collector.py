@@ -1,22 +1,98 @@
 import asyncio
 import logging
+import re
+import time
 from datetime import datetime, timezone
 
 import httpx
 
 from where_the_plow.client import (
     fetch_source,
+    is_file_url,
     parse_avl_response,
     parse_aatracking_response,
     parse_hitechmaps_response,
     parse_geotab_response,
 )
-from where_the_plow.config import SOURCES
+from where_the_plow.config import SOURCES, settings
 from where_the_plow.db import Database
 from where_the_plow.snapshot import build_realtime_snapshot
 
 logger = logging.getLogger(__name__)
 
+# A source's idle connection must outlive its poll interval, or every poll
+# pays a fresh TLS handshake; keep it open at least this long past the interval.
+KEEPALIVE_MARGIN_SECONDS = 10
+
+# While a source keeps failing with the same error, log a summary at most this often.
+REPEATED_ERROR_SUMMARY_SECONDS = 300
+
+
+_URL_RE = re.compile(r"\w+://[^\s'\"]+")
+
+
+def error_category(exc: Exception) -> str:
+    """Describe an error without volatile parts, so repeats compare equal.
+
+    HTTP errors reduce to their status code; anything else has URLs stripped
+    (e.g. the Geotab signed URL, which changes on every request).
+    """
+    if isinstance(exc, httpx.HTTPStatusError):
+        return f"{type(exc).__name__}: HTTP {exc.response.status_code}"
+    return f"{type(exc).__name__}: {_URL_RE.sub('<url>', str(exc))}"
+
+
+class ErrorThrottle:
+    """Collapse runs of identical poll errors into periodic summaries.
+
+    The first occurrence of an error is logged with its traceback; identical
+    repeats are only counted, with a "still failing" line at most once per
+    summary interval. A new, different error is logged in full again.
+    """
+
+    def __init__(
+        self, source: str, summary_interval: float = REPEATED_ERROR_SUMMARY_SECONDS
+    ):
+        self.source = source
+        self.summary_interval = summary_interval
+        self.last_error: str | None = None
+        self.first_seen: datetime | None = None
+        self.repeats = 0
+        self.failures = 0
+        self.last_logged = 0.0
+
+    def failure(self, exc: Exception):
+        error = error_category(exc)
+        now = time.monotonic()
+        self.failures += 1
+        if error != self.last_error:
+            self.last_error = error
+            self.first_seen = datetime.now(timezone.utc)
+            self.repeats = 0
+            self.last_logged = now
+            logger.error("Poll failed for %s", self.source, exc_info=exc)
+            return
+        self.repeats += 1
+        if now - self.last_logged >= self.summary_interval:
+            self.last_logged = now
+            logger.warning(
+                "[%s] still failing since %s (%d repeats of: %s)",
+                self.source,
+                self.first_seen.isoformat(timespec="seconds"),
+                self.repeats,
+                error,
+            )
+
+    def success(self):
+        if self.failures:
+            logger.info(
+                "[%s] recovered after %d failed polls", self.source, self.failures
+            )
+        self.last_error = None
+        self.first_seen = None
+        self.repeats = 0
+        self.failures = 0
+
 
 def process_poll(db: Database, response, source: str, parser: str) -> int:
     """Parse response and store vehicles/positions for a given source."""
@@ -44,10 +120,24 @@ async def poll_source(db: Database, store: dict, source_config):
         source_config.display_name,
         source_config.poll_interval,
     )
-    async with httpx.AsyncClient() as client:
+    errors = ErrorThrottle(source_config.name)
+    limits = httpx.Limits(
+        max_keepalive_connections=settings.http_max_keepalive,
+        keepalive_expiry=max(
+            settings.http_keepalive_expiry,
+            source_config.poll_interval + KEEPALIVE_MARGIN_SECONDS,
+        ),
+    )
+    async with httpx.AsyncClient(
+        timeout=settings.http_timeout, limits=limits
+    ) as client:
         while True:
             try:
-                response = await fetch_source(client, source_config)
+                # httpx applies its timeout per phase (connect, each read), so a
+                # feed trickling bytes could stall a poll; cap the whole fetch,
+                # including both of Geotab's requests.
+                async with asyncio.timeout(settings.http_fetch_deadline):
+                    response = await fetch_source(client, source_config)
                 if isinstance(response, list):
                     count = len(response)
                 else:
@@ -67,11 +157,12 @@ async def poll_source(db: Database, store: dict, source_config):
                 store["realtime"][source_config.name] = build_realtime_snapshot(
                     db, source=source_config.name
                 )
+                errors.success()
             except asyncio.CancelledError:
                 logger.info("Collector for %s shutting down", source_config.name)
                 raise
-            except Exception:
-                logger.exception("Poll failed for %s", source_config.name)
+            except Exception as e:
+                errors.failure(e)
 
             await asyncio.sleep(source_config.poll_interval)
 
@@ -89,8 +180,15 @@ async def run(db: Database, store: dict):
 
     tasks = []
     for source_config in SOURCES.values():
-        if source_config.enabled:
-            tasks.append(asyncio.create_task(poll_source(db, store, source_config)))
+        if not source_config.enabled:
+            continue
+        if is_file_url(source_config.api_url) and not settings.allow_file_sources:
+            logger.warning(
+                "Skipping %s: file: API URL requires ALLOW_FILE_SOURCES=true",
+                source_config.name,
+            )
+            continue
+        tasks.append(asyncio.create_task(poll_source(db, store, source_config)))
 
     if not tasks:
         logger.warning("No sources enabled!")
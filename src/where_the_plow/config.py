@@ -1,3 +1,4 @@
+from pydantic import Field
 from pydantic_settings import BaseSettings, SettingsConfigDict
 
 from where_the_plow.source_config import SourceConfig, build_sources
@@ -10,36 +11,84 @@ class Settings(BaseSettings):
     )
 
     # Application
-    db_path: str = "/data/plow.db"
-    log_level: str = "INFO"
+    db_path: str = Field("/data/plow.db", description="Path to DuckDB database file")
+    log_level: str = Field("INFO", description="Python log level")
+
+    allow_file_sources: bool = Field(
+        False,
+        description="Allow file:// API URLs to replay saved responses (dev only)",
+    )
 
     # Source API URLs
-    avl_api_url: str = (
-        "https://map.stjohns.ca/mapsrv/rest/services/AVL/MapServer/0/query"
+    avl_api_url: str = Field(
+        "https://map.stjohns.ca/mapsrv/rest/services/AVL/MapServer/0/query",
+        description="St. John's AVL API URL",
+    )
+    mt_pearl_api_url: str = Field(
+        "https://gps5.aatracking.com/api/MtPearlPortal/GetPlows",
+        description="Mount Pearl AATracking API URL",
     )
-    mt_pearl_api_url: str = "https://gps5.aatracking.com/api/MtPearlPortal/GetPlows"
-    provincial_api_url: str = (
-        "https://gps5.aatracking.com/api/NewfoundlandPortal/GetPlows"
+    provincial_api_url: str = Field(
+        "https://gps5.aatracking.com/api/NewfoundlandPortal/GetPlows",
+        description="Provincial AATracking API URL",
     )
-    paradise_api_url: str = "https://hitechmaps.com/townparadise/db.php"
-    cbs_api_url: str = (
+    paradise_api_url: str = Field(
+        "https://hitechmaps.com/townparadise/db.php",
+        description="Paradise HitechMaps API URL",
+    )
+    cbs_api_url: str = Field(
         "https://citizeninsights.geotab.com/urlForFileFromBucket/Canada/"
-        "equipment-tracker-cbs-lp038h1u-b27A7-vehicle-locations.json"
+        "equipment-tracker-cbs-lp038h1u-b27A7-vehicle-locations.json",
+        description="Conception Bay South Geotab API URL",
+    )
+
+    http_timeout: float = Field(
+        10.0, gt=0, description="Seconds allowed per connect/read step of a request"
+    )
+    http_fetch_deadline: float = Field(
+        30.0, gt=0, description="Total seconds allowed for one source fetch"
+    )
+
+    http_keepalive_expiry: float = Field(
+        60.0, gt=0, description="Minimum seconds an idle source connection stays open"
+    )
+    http_max_keepalive: int = Field(
+        2, ge=0, description="Idle connections kept open per source"
     )
 
     # Source enable/disable
-    source_st_johns_enabled: bool = True
-    source_mt_pearl_enabled: bool = True
-    source_provincial_enabled: bool = True
-    source_paradise_enabled: bool = True
-    source_cbs_enabled: bool = True
+    source_st_johns_enabled: bool = Field(
+        True, description="Enable/disable St. John's source"
+    )
+    source_mt_pearl_enabled: bool = Field(
+        True, description="Enable/disable Mount Pearl source"
+    )
+    source_provincial_enabled: bool = Field(
+        True, description="Enable/disable Provincial source"
+    )
+    source_paradise_enabled: bool = Field(
+        True, description="Enable/disable Paradise source"
+    )
+    source_cbs_enabled: bool = Field(
+        True, description="Enable/disable Conception Bay South source"
+    )
 
     # Source poll intervals (seconds)
-    source_st_johns_poll_interval: int = 6
-    source_mt_pearl_poll_interval: int = 30
-    source_provincial_poll_interval: int = 30
-    source_paradise_poll_interval: int = 10
-    source_cbs_poll_interval: int = 15
+    source_st_johns_poll_interval: int = Field(
+        6, description="St. John's poll interval (seconds)"
+    )
+    source_mt_pearl_poll_interval: int = Field(
+        30, description="Mount Pearl poll interval (seconds)"
+    )
+    source_provincial_poll_interval: int = Field(
+        30, description="Provincial poll interval (seconds)"
+    )
+    source_paradise_poll_interval: int = Field(
+        10, description="Paradise poll interval (seconds)"
+    )
+    source_cbs_poll_interval: int = Field(
+        15, description="Conception Bay South poll interval (seconds)"
+    )
 
 
 settings = Settings()
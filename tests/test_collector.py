@@ -6,8 +6,9 @@ from unittest.mock import AsyncMock, patch
 import httpx
 import pytest
 
-from where_the_plow.client import fetch_source
-from where_the_plow.collector import poll_source, process_poll
+from where_the_plow.client import fetch_source, is_file_url
+from where_the_plow import collector
+from where_the_plow.collector import ErrorThrottle, poll_source, process_poll
 from where_the_plow.db import Database
 from where_the_plow.source_config import SourceConfig
 
@@ -369,6 +370,152 @@ async def test_poll_source_cancellation_is_clean():
     os.unlink(path)
 
 
+async def test_poll_source_throttles_repeated_errors(caplog):
+    """Identical consecutive failures are logged once, then recovery is noted."""
+    db, path = make_db()
+    store = {}
+    config = _test_source_config()
+
+    error = httpx.ConnectTimeout("Connection timed out")
+    effects = [error, error, error, _make_aatracking_response()]
+
+    with caplog.at_level("INFO", logger="where_the_plow.collector"):
+        await _run_poll_cycles(db, store, config, effects)
+
+    failed = [r for r in caplog.records if "Poll failed" in r.getMessage()]
+    assert len(failed) == 1
+    messages = [r.getMessage() for r in caplog.records]
+    assert any("recovered after 3 failed polls" in m for m in messages)
+
+    db.close()
+    os.unlink(path)
+
+
+async def _capture_client_kwargs(db, store, config) -> dict:
+    """Run one poll cycle and return the kwargs poll_source built its client with."""
+    captured = {}
+    real_client = httpx.AsyncClient
+
+    def fake_client(**kwargs):
+        captured.update(kwargs)
+        return real_client(**kwargs)
+
+    with patch("where_the_plow.collector.httpx.AsyncClient", side_effect=fake_client):
+        await _run_poll_cycles(db, store, config, [_make_aatracking_response()])
+    return captured
+
+
+async def test_poll_source_client_keeps_connections_alive(monkeypatch):
+    """poll_source should apply the keep-alive settings to its HTTP client."""
+    db, path = make_db()
+    monkeypatch.setattr(collector.settings, "http_keepalive_expiry", 45.0)
+    monkeypatch.setattr(collector.settings, "http_max_keepalive", 3)
+
+    kwargs = await _capture_client_kwargs(db, {}, _test_source_config())
+
+    assert kwargs["limits"].keepalive_expiry == 45.0
+    assert kwargs["limits"].max_keepalive_connections == 3
+
+    db.close()
+    os.unlink(path)
+
+
+async def test_poll_source_keepalive_outlives_long_poll_interval(monkeypatch):
+    """Sources polled less often than HTTP_KEEPALIVE_EXPIRY still reuse connections."""
+    db, path = make_db()
+    monkeypatch.setattr(collector.settings, "http_keepalive_expiry", 60.0)
+    config = _test_source_config(poll_interval=120)
+
+    kwargs = await _capture_client_kwargs(db, {}, config)
+
+    assert kwargs["limits"].keepalive_expiry > 120
+
+    db.close()
+    os.unlink(path)
+
+
+async def test_poll_source_client_uses_http_timeout(monkeypatch):
+    """poll_source should build its HTTP client with the HTTP_TIMEOUT setting."""
+    db, path = make_db()
+    monkeypatch.setattr(collector.settings, "http_timeout", 2.5)
+
+    kwargs = await _capture_client_kwargs(db, {}, _test_source_config())
+
+    assert kwargs["timeout"] == 2.5
+
+    db.close()
+    os.unlink(path)
+
+
+async def test_poll_source_enforces_total_fetch_timeout(monkeypatch):
+    """A fetch stalled past HTTP_FETCH_DEADLINE is abandoned and counted as failed."""
+    db, path = make_db()
+    monkeypatch.setattr(collector.settings, "http_fetch_deadline", 0.01)
+    failures = []
+    failed = asyncio.Event()
+
+    async def stalled_fetch(client, source):
+        await asyncio.Event().wait()
+
+    def record_failure(self, exc):
+        failures.append(exc)
+        failed.set()
+
+    with (
+        patch("where_the_plow.collector.fetch_source", side_effect=stalled_fetch),
+        patch.object(ErrorThrottle, "failure", record_failure),
+    ):
+        task = asyncio.create_task(poll_source(db, {}, _test_source_config()))
+        await asyncio.wait_for(failed.wait(), timeout=5.0)
+        task.cancel()
+        with pytest.raises(asyncio.CancelledError):
+            await task
+
+    assert isinstance(failures[0], TimeoutError)
+
+    db.close()
+    os.unlink(path)
+
+
+def test_error_throttle_collapses_http_errors_differing_only_by_url(caplog):
+    """Signed URLs change every poll; the same status must still count as a repeat."""
+    throttle = ErrorThrottle("cbs", summary_interval=0)
+
+    def forbidden(sig):
+        url = f"https://storage.googleapis.com/bucket/cbs.json?sig={sig}"
+        return httpx.HTTPStatusError(
+            f"Client error '403 Forbidden' for url '{url}'",
+            request=httpx.Request("GET", url),
+            response=httpx.Response(403),
+        )
+
+    with caplog.at_level("INFO", logger="where_the_plow.collector"):
+        throttle.failure(forbidden("abc"))
+        throttle.failure(forbidden("xyz"))
+
+    messages = [r.getMessage() for r in caplog.records]
+    assert messages.count("Poll failed for cbs") == 1
+    assert any("(1 repeats of: HTTPStatusError: HTTP 403)" in m for m in messages)
+
+
+def test_error_throttle_logs_new_errors_and_summaries(caplog):
+    """New errors are logged in full; repeats only produce "still failing" lines."""
+    throttle = ErrorThrottle("test_source", summary_interval=0)
+
+    with caplog.at_level("INFO", logger="where_the_plow.collector"):
+        throttle.failure(ValueError("boom"))
+        first_seen = throttle.first_seen.isoformat(timespec="seconds")
+        throttle.failure(ValueError("boom"))
+        throttle.failure(RuntimeError("different"))
+
+    messages = [r.getMessage() for r in caplog.records]
+    assert messages.count("Poll failed for test_source") == 2
+    assert (
+        f"[test_source] still failing since {first_seen} "
+        "(1 repeats of: ValueError: boom)"
+    ) in messages
+
+
 # ── Async test: fetch_source behavior ────────────────────────────────
 
 
@@ -445,3 +592,70 @@ async def test_fetch_source_geotab_two_step():
     assert client.get.call_args_list[0].args[0] == config.api_url
     # Second call should be to the signed URL
     assert client.get.call_args_list[1].args[0] == signed_url
+
+
+async def test_fetch_source_reads_file_url(tmp_path):
+    """file:// API URLs should be read from disk without touching the network."""
+    fixture = tmp_path / "geotab.json"
+    fixture.write_text('{"b21": [-52.93, 47.51]}')
+    config = _test_source_config(parser="geotab", api_url=f"file://{fixture}")
+
+    client = AsyncMock(spec=httpx.AsyncClient)
+
+    result = await fetch_source(client, config)
+
+    assert result == {"b21": [-52.93, 47.51]}
+    client.get.assert_not_called()
+
+
+async def test_fetch_source_reads_localhost_file_url(tmp_path):
+    """file://localhost/ URLs and percent-encoded paths should resolve to disk."""
+    fixture = tmp_path / "saved feed.json"
+    fixture.write_text("[]")
+    url = "file://localhost" + fixture.as_posix().replace(" ", "%20")
+    config = _test_source_config(api_url=url)
+
+    result = await fetch_source(AsyncMock(spec=httpx.AsyncClient), config)
+
+    assert result == []
+
+
+def test_is_file_url_checks_scheme():
+    assert is_file_url("file:///tmp/avl.json")
+    assert is_file_url("file:/tmp/avl.json")
+    assert is_file_url("FILE://localhost/tmp/avl.json")
+    assert not is_file_url("https://example.com/file://x")
+
+
+async def test_fetch_source_reads_single_slash_file_url(tmp_path):
+    """file:/path URLs (no authority) should be read from disk too."""
+    fixture = tmp_path / "avl.json"
+    fixture.write_text('{"features": []}')
+    config = _test_source_config(parser="avl", api_url=f"file:{fixture}")
+
+    result = await fetch_source(AsyncMock(spec=httpx.AsyncClient), config)
+
+    assert result == {"features": []}
+
+
+async def test_run_skips_file_sources_unless_allowed(monkeypatch, caplog):
+    """file:// sources are only polled when ALLOW_FILE_SOURCES is enabled."""
+    db, path = make_db()
+    config = _test_source_config(api_url="file:///tmp/fixture.json")
+    monkeypatch.setattr(collector, "SOURCES", {config.name: config})
+    fake_poll = AsyncMock()
+    monkeypatch.setattr(collector, "poll_source", fake_poll)
+
+    monkeypatch.setattr(collector.settings, "allow_file_sources", False)
+    with caplog.at_level("WARNING", logger="where_the_plow.collector"):
+        await collector.run(db, {})
+    fake_poll.assert_not_called()
+    assert any("Skipping test_source" in r.getMessage() for r in caplog.records)
+
+    monkeypatch.setattr(collector.settings, "allow_file_sources", True)
+    await collector.run(db, {})
+    fake_poll.assert_called_once()
+    assert fake_poll.call_args.args[2] is config
+
+    db.close()
+    os.unlink(path)
@@ -1,3 +1,6 @@
+import pytest
+from pydantic import ValidationError
+
 from where_the_plow.config import Settings, settings, SOURCES
 from where_the_plow.source_config import SourceConfig, build_sources
 
@@ -11,11 +14,22 @@ def test_default_settings():
     assert s.source_paradise_poll_interval == 10
     assert s.source_cbs_poll_interval == 15
     assert s.log_level == "INFO"
+    assert s.allow_file_sources is False
+    assert s.http_timeout == 10.0
+    assert s.http_fetch_deadline == 30.0
+    assert s.http_keepalive_expiry == 60.0
+    assert s.http_max_keepalive == 2
     assert "MapServer" in s.avl_api_url
     assert "hitechmaps.com" in s.paradise_api_url
     assert "citizeninsights.geotab.com" in s.cbs_api_url
 
 
+def test_every_setting_has_description():
+    """cli.py env prints these, so every setting needs one."""
+    for name, field in Settings.model_fields.items():
+        assert field.description, f"{name} has no description"
+
+
 def test_settings_from_env(monkeypatch):
     monkeypatch.setenv("DB_PATH", "/tmp/test.db")
     monkeypatch.setenv("SOURCE_ST_JOHNS_POLL_INTERVAL", "10")
@@ -26,6 +40,22 @@ def test_settings_from_env(monkeypatch):
     assert s.log_level == "DEBUG"
 
 
+def test_http_timeouts_from_env(monkeypatch):
+    monkeypatch.setenv("HTTP_TIMEOUT", "2.5")
+    monkeypatch.setenv("HTTP_FETCH_DEADLINE", "7")
+    s = Settings()
+    assert s.http_timeout == 2.5
+    assert s.http_fetch_deadline == 7.0
+
+
+@pytest.mark.parametrize("name", ["HTTP_TIMEOUT", "HTTP_FETCH_DEADLINE"])
+@pytest.mark.parametrize("value", ["0", "-5"])
+def test_http_timeouts_must_be_positive(monkeypatch, name, value):
+    monkeypatch.setenv(name, value)
+    with pytest.raises(ValidationError):
+        Settings()
+
+
 def test_source_enabled_from_env(monkeypatch):
     """SOURCE_*_ENABLED env vars control source enable/disable."""
     monkeypatch.setenv("SOURCE_ST_JOHNS_ENABLED", "false")